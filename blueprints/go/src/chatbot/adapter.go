@@ -0,0 +1,25 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// adapter.go - The Adapter interface implemented by each input channel the
+// bot can listen on (see adapters/cli, adapters/slack, adapters/http).
+
+package chatbot
+
+import "context"
+
+// Adapter connects the Brain to one input channel (a terminal, a chat
+// platform, a webhook, ...). Receive starts delivering inbound messages on
+// the returned channel and must close it once ctx is done. Send delivers a
+// reply back out through the same channel.
+type Adapter interface {
+	// Name identifies the adapter, e.g. for logging.
+	Name() string
+	// Receive returns a channel of inbound messages. It must close the
+	// channel when ctx is canceled.
+	Receive(ctx context.Context) <-chan Message
+	// Send delivers a reply message through this adapter.
+	Send(msg Message) error
+}
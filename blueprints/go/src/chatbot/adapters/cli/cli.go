@@ -0,0 +1,67 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// cli.go - A chatbot.Adapter that reads lines from stdin and prints replies
+// to stdout. This is the interactive terminal experience main.go offered
+// before adapters existed.
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+)
+
+// Adapter is a chatbot.Adapter for the local terminal.
+type Adapter struct {
+	botName string
+}
+
+// New returns a cli.Adapter that labels replies with botName.
+func New(botName string) *Adapter {
+	return &Adapter{botName: botName}
+}
+
+// Name identifies this adapter.
+func (a *Adapter) Name() string { return "cli" }
+
+// Receive prints a "You: " prompt and reads one line of stdin per message.
+// Typing "bye" or reaching EOF ends the conversation and closes the
+// channel; canceling ctx does the same.
+func (a *Adapter) Receive(ctx context.Context) <-chan chatbot.Message {
+	out := make(chan chatbot.Message)
+	go func() {
+		defer close(out)
+		fmt.Printf("Chat with %s! Type 'bye' to exit.\n", a.botName)
+		scanner := bufio.NewScanner(os.Stdin)
+		for {
+			fmt.Print("You: ")
+			if !scanner.Scan() {
+				return
+			}
+			text := strings.TrimSpace(scanner.Text())
+			if strings.EqualFold(text, "bye") {
+				fmt.Printf("%s: Goodbye!\n", a.botName)
+				return
+			}
+			select {
+			case out <- chatbot.Message{UserID: "local", Text: text}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Send prints a reply to stdout.
+func (a *Adapter) Send(msg chatbot.Message) error {
+	_, err := fmt.Printf("%s: %s\n", a.botName, msg.Text)
+	return err
+}
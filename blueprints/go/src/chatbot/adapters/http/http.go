@@ -0,0 +1,212 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// http.go - A chatbot.Adapter that exposes the bot as a JSON webhook: POST
+// /message sends one message and waits for its reply, and GET /stream opens
+// a Server-Sent Events connection that relays every reply as it is sent.
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+)
+
+// Adapter is a chatbot.Adapter backed by an HTTP server.
+type Adapter struct {
+	addr string
+	srv  *http.Server
+
+	mu      sync.Mutex
+	pending map[string]chan string
+	subs    map[string][]chan string
+	nextID  uint64
+}
+
+// New returns an http.Adapter that will listen on addr (e.g. ":8080") once
+// Receive is called.
+func New(addr string) *Adapter {
+	return &Adapter{
+		addr:    addr,
+		pending: make(map[string]chan string),
+		subs:    make(map[string][]chan string),
+	}
+}
+
+// Name identifies this adapter.
+func (a *Adapter) Name() string { return "http" }
+
+// Receive starts the HTTP server and returns a channel fed by POST
+// /message requests. The channel closes once the server shuts down, which
+// happens automatically when ctx is canceled.
+func (a *Adapter) Receive(ctx context.Context) <-chan chatbot.Message {
+	out := make(chan chatbot.Message)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/message", a.handleMessage(ctx, out))
+	mux.HandleFunc("/stream", a.handleStream)
+	a.srv = &http.Server{Addr: a.addr, Handler: mux}
+
+	go func() {
+		if err := a.srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("chatbot: http adapter: %v\n", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		a.srv.Shutdown(shutdownCtx)
+		close(out)
+	}()
+
+	return out
+}
+
+type messageRequest struct {
+	UserID string `json:"user_id"`
+	Text   string `json:"text"`
+}
+
+type messageResponse struct {
+	Reply string `json:"reply"`
+}
+
+// handleMessage accepts one JSON {user_id, text} message, forwards it to
+// out, and blocks until the matching reply arrives (or the request's
+// context is canceled), then writes {reply}.
+func (a *Adapter) handleMessage(ctx context.Context, out chan<- chatbot.Message) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req messageRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+
+		id := a.newRequestID()
+		reply := make(chan string, 1)
+		a.mu.Lock()
+		a.pending[id] = reply
+		a.mu.Unlock()
+		defer func() {
+			a.mu.Lock()
+			delete(a.pending, id)
+			a.mu.Unlock()
+		}()
+
+		select {
+		case out <- chatbot.Message{UserID: req.UserID, Text: req.Text, ID: id}:
+		case <-ctx.Done():
+			http.Error(w, "server shutting down", http.StatusServiceUnavailable)
+			return
+		case <-r.Context().Done():
+			return
+		}
+
+		select {
+		case text := <-reply:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(messageResponse{Reply: text})
+		case <-ctx.Done():
+			http.Error(w, "server shutting down", http.StatusServiceUnavailable)
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// handleStream subscribes the caller to every reply sent to the user_id
+// query parameter, streaming each as a Server-Sent Event until the client
+// disconnects.
+func (a *Adapter) handleStream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	userID := r.URL.Query().Get("user_id")
+
+	ch := make(chan string, 8)
+	a.mu.Lock()
+	a.subs[userID] = append(a.subs[userID], ch)
+	a.mu.Unlock()
+	defer a.unsubscribe(userID, ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	for {
+		select {
+		case text := <-ch:
+			writeSSE(w, text)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// writeSSE writes text as a Server-Sent Event, splitting it into one
+// "data: " line per line of text (per the SSE spec) so an embedded newline
+// in a multi-line reply, e.g. one produced by a Provider, doesn't
+// truncate the event early.
+func writeSSE(w http.ResponseWriter, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	fmt.Fprint(w, "\n")
+}
+
+func (a *Adapter) unsubscribe(userID string, ch chan string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	subs := a.subs[userID]
+	for i, c := range subs {
+		if c == ch {
+			a.subs[userID] = append(subs[:i], subs[i+1:]...)
+			break
+		}
+	}
+}
+
+// Send delivers a reply to whichever POST /message call or /stream
+// subscribers are waiting on msg.
+func (a *Adapter) Send(msg chatbot.Message) error {
+	a.mu.Lock()
+	reply, hasPending := a.pending[msg.ID]
+	subs := append([]chan string(nil), a.subs[msg.UserID]...)
+	a.mu.Unlock()
+
+	if hasPending {
+		reply <- msg.Text
+	}
+	for _, ch := range subs {
+		select {
+		case ch <- msg.Text:
+		default:
+		}
+	}
+	return nil
+}
+
+func (a *Adapter) newRequestID() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.nextID++
+	return strconv.FormatUint(a.nextID, 10)
+}
@@ -0,0 +1,98 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// slack.go - A chatbot.Adapter backed by a Slack app connected over Socket
+// Mode, so the bot can run without an inbound webhook URL.
+package slack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+
+	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+)
+
+// Adapter is a chatbot.Adapter for a Slack app running in Socket Mode.
+type Adapter struct {
+	api    *slack.Client
+	client *socketmode.Client
+}
+
+// New returns a slack.Adapter authenticated with a bot token (xoxb-...)
+// and an app-level token (xapp-...) with the connections:write scope.
+func New(botToken, appToken string) *Adapter {
+	api := slack.New(botToken, slack.OptionAppLevelToken(appToken))
+	return &Adapter{
+		api:    api,
+		client: socketmode.New(api),
+	}
+}
+
+// Name identifies this adapter.
+func (a *Adapter) Name() string { return "slack" }
+
+// Receive opens the Socket Mode connection and translates incoming
+// message events into chatbot.Messages. It closes the returned channel
+// once ctx is canceled.
+func (a *Adapter) Receive(ctx context.Context) <-chan chatbot.Message {
+	out := make(chan chatbot.Message)
+
+	go a.client.RunContext(ctx)
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt, ok := <-a.client.Events:
+				if !ok {
+					return
+				}
+				a.handleEvent(ctx, evt, out)
+			}
+		}
+	}()
+
+	return out
+}
+
+func (a *Adapter) handleEvent(ctx context.Context, evt socketmode.Event, out chan<- chatbot.Message) {
+	if evt.Type != socketmode.EventTypeEventsAPI {
+		return
+	}
+	eventsAPI, ok := evt.Data.(slackevents.EventsAPIEvent)
+	if !ok {
+		return
+	}
+	if evt.Request != nil {
+		a.client.Ack(*evt.Request)
+	}
+
+	inner, ok := eventsAPI.InnerEvent.Data.(*slackevents.MessageEvent)
+	if !ok || inner.BotID != "" {
+		return
+	}
+
+	msg := chatbot.Message{UserID: inner.User, Text: inner.Text, ID: inner.Channel}
+	select {
+	case out <- msg:
+	case <-ctx.Done():
+	}
+}
+
+// Send posts a reply to the Slack channel the inbound message came from
+// (carried in Message.ID, set by Receive).
+func (a *Adapter) Send(msg chatbot.Message) error {
+	_, _, err := a.api.PostMessage(msg.ID, slack.MsgOptionText(msg.Text, false))
+	if err != nil {
+		return fmt.Errorf("chatbot: slack adapter: post message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// brain.go - Brain wires one or more Adapters to a Bot: it fans inbound
+// messages in, runs them through optional middleware and any registered
+// custom handlers, and sends replies back out through the adapter that
+// received them.
+
+package chatbot
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// HandlerFunc processes one inbound message and returns a reply. handled
+// reports whether it produced a reply at all; a false return lets the
+// Brain fall through to the next handler.
+type HandlerFunc func(ctx context.Context, msg Message) (reply string, handled bool)
+
+// Middleware wraps a HandlerFunc to add cross-cutting behavior such as
+// authentication, rate limiting, or logging.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// customHandler pairs a compiled trigger pattern (reusing the same
+// wildcard syntax as Rules) with the function it dispatches to.
+type customHandler struct {
+	trigger trigger
+	fn      HandlerFunc
+}
+
+// Brain owns a Bot, fans messages in from its Adapters, and dispatches
+// each one to a custom handler if its pattern matches, falling back to
+// Bot.Respond otherwise.
+type Brain struct {
+	bot *Bot
+
+	mu         sync.Mutex
+	adapters   []Adapter
+	handlers   []customHandler
+	middleware []Middleware
+}
+
+// NewBrain returns a Brain that answers with bot when no registered
+// handler matches an inbound message.
+func NewBrain(bot *Bot) *Brain {
+	return &Brain{bot: bot}
+}
+
+// AddAdapter registers an adapter to receive from and send replies through.
+// It must be called before Run.
+func (b *Brain) AddAdapter(a Adapter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.adapters = append(b.adapters, a)
+}
+
+// Use appends mw to the middleware chain. Middleware runs in the order it
+// was added, outermost first, wrapping both custom handlers and the
+// Bot.Respond fallback.
+func (b *Brain) Use(mw Middleware) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.middleware = append(b.middleware, mw)
+}
+
+// RegisterHandler dispatches messages matching pattern to fn instead of
+// Bot.Respond. pattern uses the same "*" wildcard syntax as a Rules
+// trigger. Handlers are tried in registration order; the first matching
+// handler that reports handled wins.
+func (b *Brain) RegisterHandler(pattern string, fn HandlerFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, customHandler{
+		trigger: compileTrigger(normalize(pattern)),
+		fn:      fn,
+	})
+}
+
+// dispatch tries each registered handler in order before falling back to
+// the bot's rule-based Respond.
+func (b *Brain) dispatch(ctx context.Context, msg Message) (string, bool) {
+	in := normalize(msg.Text)
+	for _, h := range b.handlers {
+		if h.trigger.re.MatchString(in) {
+			if reply, handled := h.fn(ctx, msg); handled {
+				return reply, true
+			}
+		}
+	}
+	return b.bot.RespondAsContext(ctx, msg.UserID, msg.Text), true
+}
+
+// handler builds the dispatch function with all registered middleware
+// applied, outermost first.
+func (b *Brain) handler() HandlerFunc {
+	h := b.dispatch
+	for i := len(b.middleware) - 1; i >= 0; i-- {
+		h = b.middleware[i](h)
+	}
+	return h
+}
+
+// inbound pairs a received message with the adapter it arrived on, so the
+// reply can be sent back through the same adapter.
+type inbound struct {
+	adapter Adapter
+	msg     Message
+}
+
+// Run fans inbound messages in from every registered adapter, dispatches
+// each through the handler chain, and sends the reply back out through the
+// adapter it arrived on. It blocks until ctx is canceled or every adapter
+// has closed its Receive channel, and returns ctx.Err() (nil when the
+// adapters closed without ctx being canceled). On cancellation it returns
+// at once, without waiting for adapters to close their Receive channels.
+func (b *Brain) Run(ctx context.Context) error {
+	agg := make(chan inbound)
+	var wg sync.WaitGroup
+	for _, a := range b.adapters {
+		wg.Add(1)
+		go func(a Adapter) {
+			defer wg.Done()
+			for msg := range a.Receive(ctx) {
+				select {
+				case agg <- inbound{adapter: a, msg: msg}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(a)
+	}
+	go func() {
+		wg.Wait()
+		close(agg)
+	}()
+
+	h := b.handler()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case in, ok := <-agg:
+			if !ok {
+				return ctx.Err()
+			}
+			reply, handled := h(ctx, in.msg)
+			if !handled {
+				continue
+			}
+			out := Message{UserID: in.msg.UserID, Text: reply, ID: in.msg.ID}
+			if err := in.adapter.Send(out); err != nil {
+				fmt.Fprintf(os.Stderr, "chatbot: %s: send: %v\n", in.adapter.Name(), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// brain_test.go - Unit tests for Brain's dispatch, middleware, and
+// adapter fan-in/fan-out, using a small in-memory fakeAdapter.
+
+package chatbot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAdapter is an Adapter driven entirely in memory, for tests.
+type fakeAdapter struct {
+	in   chan Message
+	sent chan Message
+}
+
+func newFakeAdapter() *fakeAdapter {
+	return &fakeAdapter{in: make(chan Message), sent: make(chan Message, 8)}
+}
+
+func (a *fakeAdapter) Name() string { return "fake" }
+
+func (a *fakeAdapter) Receive(ctx context.Context) <-chan Message {
+	out := make(chan Message)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case msg := <-a.in:
+				out <- msg
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (a *fakeAdapter) Send(msg Message) error {
+	a.sent <- msg
+	return nil
+}
+
+// Tests that an unmatched message falls back to the bot's rule-based
+// Respond.
+func TestBrain_FallsBackToBot(t *testing.T) {
+	brain := NewBrain(NewBot("TestBot"))
+	adapter := newFakeAdapter()
+	brain.AddAdapter(adapter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go brain.Run(ctx)
+
+	adapter.in <- Message{UserID: "u1", Text: "hi"}
+	select {
+	case reply := <-adapter.sent:
+		assert.Equal(t, "Hello! How can I help you today?", reply.Text)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for reply")
+	}
+}
+
+// Tests that a registered handler intercepts matching input before it
+// reaches the bot.
+func TestBrain_RegisterHandler(t *testing.T) {
+	brain := NewBrain(NewBot("TestBot"))
+	adapter := newFakeAdapter()
+	brain.AddAdapter(adapter)
+	brain.RegisterHandler("ping", func(ctx context.Context, msg Message) (string, bool) {
+		return "pong", true
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go brain.Run(ctx)
+
+	adapter.in <- Message{UserID: "u1", Text: "ping"}
+	select {
+	case reply := <-adapter.sent:
+		assert.Equal(t, "pong", reply.Text)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for reply")
+	}
+}
+
+// Tests that middleware wraps the handler chain and can short-circuit it.
+func TestBrain_Middleware(t *testing.T) {
+	brain := NewBrain(NewBot("TestBot"))
+	adapter := newFakeAdapter()
+	brain.AddAdapter(adapter)
+	brain.Use(func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg Message) (string, bool) {
+			if msg.UserID == "blocked" {
+				return "access denied", true
+			}
+			return next(ctx, msg)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go brain.Run(ctx)
+
+	adapter.in <- Message{UserID: "blocked", Text: "hi"}
+	select {
+	case reply := <-adapter.sent:
+		assert.Equal(t, "access denied", reply.Text)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for reply")
+	}
+}
+
+// Tests that Run returns once ctx is canceled.
+func TestBrain_RunStopsOnCancel(t *testing.T) {
+	brain := NewBrain(NewBot("TestBot"))
+	brain.AddAdapter(newFakeAdapter())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- brain.Run(ctx) }()
+	cancel()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after cancel")
+	}
+}
@@ -3,37 +3,244 @@
 // Part of the dev repo: https://github.com/christimahu/dev/
 // This file is part of a minimal idiomatic Go blueprint for creating new applications.
 //
-// chatbot.go - A trivial chatbot that responds to common phrases.
+// chatbot.go - A small chatbot whose replies come from a Rules engine
+// (see rules.go), with optional per-user Memory (see memory.go).
 // This file demonstrates how to define structs and methods in Go.
 
 package chatbot
 
-import "strings"
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
 
-// Bot is a chatbot that knows its name and replies to a few known inputs.
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/memory/inmem"
+)
+
+//go:embed default.rive
+var defaultScript string
+
+// unknownResponse is returned when no rule matches the input.
+const unknownResponse = "I'm not sure how to respond to that."
+
+// defaultMinConfidence is the fuzzy-match score (see Matcher) below which
+// RespondWithScore and the Respond family fall through to Provider/unknown
+// rather than accept a typo-tolerant guess.
+const defaultMinConfidence = 0.75
+
+// Bot is a chatbot that knows its name and replies according to its Rules.
+// If Memory is set, handlers and rules can remember user-specific data
+// across turns via the "set"/"get"/"forget" built-in commands. If Provider
+// is set, it is consulted whenever no rule matches, instead of returning
+// the default unmatched response. When no rule matches input exactly,
+// MinConfidence controls how readily a fuzzy, typo-tolerant match is
+// accepted instead (see Matcher); it defaults to 0.75.
 type Bot struct {
-	Name string
+	Name          string
+	Rules         *Rules
+	Memory        Memory
+	Provider      Provider
+	MinConfidence float64
+
+	matcher   *Matcher
+	lastReply string
 }
 
-// NewBot returns a new Bot instance with the provided name.
-// This is the idiomatic Go approach for constructors.
+// NewBot returns a new Bot instance with the provided name, using the
+// built-in default script for its rules and an in-process Memory.
 func NewBot(name string) *Bot {
-	return &Bot{Name: name}
+	r := NewRules()
+	if err := r.LoadString(defaultScript); err != nil {
+		panic("chatbot: invalid built-in default script: " + err.Error())
+	}
+	bot := NewBotWithRules(name, r)
+	bot.Memory = inmem.New()
+	return bot
 }
 
-// Respond returns a simple reply string for known inputs.
-// Any unknown input returns a default response.
+// NewBotWithRules returns a new Bot instance with the provided name and a
+// custom rule set, e.g. one loaded from an external script via
+// Rules.LoadFile. Memory is left unset; assign Bot.Memory to enable the
+// "set"/"get"/"forget" commands.
+func NewBotWithRules(name string, r *Rules) *Bot {
+	return &Bot{Name: name, Rules: r, MinConfidence: defaultMinConfidence, matcher: NewMatcher()}
+}
+
+// Respond returns a reply for input, scoped to no particular user. It is
+// equivalent to RespondAs("", input).
 func (b *Bot) Respond(input string) string {
-	switch strings.ToLower(strings.TrimSpace(input)) {
-	case "hi", "hello":
-		return "Hello! How can I help you today?"
-	case "how are you?":
-		return "I'm just code, but I'm functioning as expected!"
-	case "what's your name?":
-		return "My name is " + b.Name + "."
-	case "help":
-		return "You can say things like 'hi', 'how are you', or 'what's your name'."
+	return b.RespondAs("", input)
+}
+
+// RespondAs returns a reply for input on behalf of userID, so that Memory
+// commands and any rule-driven recall are scoped per user. Any unmatched
+// input is forwarded to Provider if one is set, otherwise it returns a
+// default response. The reply is remembered so a later input's rule can
+// use a "%" context line to match on it.
+//
+// RespondAs runs Provider.Complete (if reached) against context.Background,
+// so it can never be canceled; callers that hold a real context, such as
+// Brain.dispatch, should use RespondAsContext instead.
+func (b *Bot) RespondAs(userID, input string) string {
+	return b.RespondAsContext(context.Background(), userID, input)
+}
+
+// RespondAsContext behaves like RespondAs, except that ctx is passed through
+// to Provider.Complete, so canceling ctx (e.g. on Brain shutdown) aborts an
+// in-flight fallback call instead of blocking until it returns.
+func (b *Bot) RespondAsContext(ctx context.Context, userID, input string) string {
+	reply, err := b.resolveReply(ctx, userID, input, nil)
+	if err != nil {
+		reply = fmt.Sprintf("I hit an error reaching my fallback: %v", err)
+	}
+	b.lastReply = reply
+	return reply
+}
+
+// RespondStream behaves like Respond, except that when input falls
+// through to Provider, onToken is invoked once per token as the reply
+// streams in. For a reply produced by a Memory command or a rule match
+// (which arrive all at once), onToken is still invoked exactly once with
+// the full reply, so callers can always print through onToken alone.
+//
+// Like RespondAs, RespondStream runs Provider.Complete against
+// context.Background; use RespondStreamContext to make it cancelable.
+func (b *Bot) RespondStream(input string, onToken func(token string)) (string, error) {
+	return b.RespondStreamContext(context.Background(), input, onToken)
+}
+
+// RespondStreamContext behaves like RespondStream, except that ctx is
+// passed through to Provider.Complete so an in-flight streaming call can be
+// canceled.
+func (b *Bot) RespondStreamContext(ctx context.Context, input string, onToken func(token string)) (string, error) {
+	reply, err := b.resolveReply(ctx, "", input, onToken)
+	b.lastReply = reply
+	return reply, err
+}
+
+// RespondWithScore returns a reply for input along with the trigger it
+// matched and a confidence score in [0, 1]: 1.0 for a Memory command or an
+// exact Rules match, the fuzzy similarity score for a typo-tolerant match
+// accepted via MinConfidence, or 0 when the reply came from Provider or the
+// default unmatched response (neither of which has a trigger to report).
+//
+// Like RespondAs, RespondWithScore runs Provider.Complete against
+// context.Background; use RespondWithScoreContext to make it cancelable.
+func (b *Bot) RespondWithScore(input string) (reply, matched string, score float64) {
+	return b.RespondWithScoreContext(context.Background(), input)
+}
+
+// RespondWithScoreContext behaves like RespondWithScore, except that ctx is
+// passed through to Provider.Complete so an in-flight fallback call can be
+// canceled.
+func (b *Bot) RespondWithScoreContext(ctx context.Context, input string) (reply, matched string, score float64) {
+	if reply, handled := b.handleMemoryCommand("", input); handled {
+		b.lastReply = reply
+		return reply, "", 1
+	}
+	vars := map[string]string{"name": b.Name}
+	if reply, trig, ok := b.Rules.Match(input, b.lastReply, vars); ok {
+		b.lastReply = reply
+		return reply, trig, 1
+	}
+	if reply, trig, s, ok := b.Rules.FuzzyMatch(b.matcher, input, b.lastReply, vars, b.MinConfidence); ok {
+		b.lastReply = reply
+		return reply, trig, s
+	}
+	if b.Provider != nil {
+		reply, err := b.Provider.Complete(ctx, input, nil)
+		if err != nil {
+			reply = fmt.Sprintf("I hit an error reaching my fallback: %v", err)
+		}
+		b.lastReply = reply
+		return reply, "", 0
+	}
+	b.lastReply = unknownResponse
+	return unknownResponse, "", 0
+}
+
+// resolveReply is the shared implementation behind RespondAs and
+// RespondStream: try a Memory command, then an exact Rules match, then a
+// fuzzy Rules match, then Provider.
+func (b *Bot) resolveReply(ctx context.Context, userID, input string, stream func(string)) (string, error) {
+	if reply, handled := b.handleMemoryCommand(userID, input); handled {
+		notify(stream, reply)
+		return reply, nil
+	}
+	vars := map[string]string{"name": b.Name}
+	if reply, _, ok := b.Rules.Match(input, b.lastReply, vars); ok {
+		notify(stream, reply)
+		return reply, nil
+	}
+	if reply, _, _, ok := b.Rules.FuzzyMatch(b.matcher, input, b.lastReply, vars, b.MinConfidence); ok {
+		notify(stream, reply)
+		return reply, nil
+	}
+	if b.Provider == nil {
+		notify(stream, unknownResponse)
+		return unknownResponse, nil
+	}
+	return b.Provider.Complete(ctx, input, stream)
+}
+
+// notify invokes stream with text if stream is non-nil.
+func notify(stream func(string), text string) {
+	if stream != nil {
+		stream(text)
+	}
+}
+
+// handleMemoryCommand implements the "set <key> <value>", "get <key>", and
+// "forget <key>" built-in commands for demonstrating Memory. It reports
+// handled=false (and ignores input) when Memory is unset or input isn't
+// one of those commands, so callers fall through to Rules.
+func (b *Bot) handleMemoryCommand(userID, input string) (reply string, handled bool) {
+	if b.Memory == nil {
+		return "", false
+	}
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return "", false
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case "set":
+		if len(fields) < 3 {
+			return "Usage: set <key> <value>", true
+		}
+		key, value := fields[1], strings.Join(fields[2:], " ")
+		if err := b.Memory.Set(memoryKey(userID, key), value); err != nil {
+			return fmt.Sprintf("I couldn't remember that: %v", err), true
+		}
+		return fmt.Sprintf("Got it, I'll remember %s.", key), true
+	case "get":
+		if len(fields) != 2 {
+			return "Usage: get <key>", true
+		}
+		value, ok, err := b.Memory.Get(memoryKey(userID, fields[1]))
+		if err != nil {
+			return fmt.Sprintf("I couldn't recall that: %v", err), true
+		}
+		if !ok {
+			return fmt.Sprintf("I don't remember %s.", fields[1]), true
+		}
+		return value, true
+	case "forget":
+		if len(fields) != 2 {
+			return "Usage: forget <key>", true
+		}
+		if err := b.Memory.Delete(memoryKey(userID, fields[1])); err != nil {
+			return fmt.Sprintf("I couldn't forget that: %v", err), true
+		}
+		return fmt.Sprintf("Forgot %s.", fields[1]), true
 	default:
-		return "I'm not sure how to respond to that."
+		return "", false
 	}
 }
+
+// memoryKey namespaces a Memory key by user so different users' data
+// never collide in a shared store.
+func memoryKey(userID, key string) string {
+	return userID + ":" + key
+}
@@ -54,3 +54,34 @@ func TestRespond_Unknown(t *testing.T) {
 	assert.Equal(t, "I'm not sure how to respond to that.", bot.Respond("42?"))
 	assert.Equal(t, "I'm not sure how to respond to that.", bot.Respond("What's your favorite color?"))
 }
+
+// Tests that RespondWithScore reports a perfect score and the matched
+// trigger for an exact rule match.
+func TestRespondWithScore_ExactMatch(t *testing.T) {
+	bot := NewBot("TestBot")
+	reply, matched, score := bot.RespondWithScore("hi")
+	assert.Equal(t, "Hello! How can I help you today?", reply)
+	assert.Equal(t, "hi", matched)
+	assert.Equal(t, 1.0, score)
+}
+
+// Tests that a typo still resolves to the intended rule, with a score below
+// 1 reflecting the imperfect match.
+func TestRespondWithScore_FuzzyMatch(t *testing.T) {
+	bot := NewBot("TestBot")
+	reply, matched, score := bot.RespondWithScore("helo")
+	assert.Equal(t, "Hello! How can I help you today?", reply)
+	assert.Equal(t, "hello", matched)
+	assert.Greater(t, score, bot.MinConfidence)
+	assert.Less(t, score, 1.0)
+}
+
+// Tests that input too dissimilar from any trigger falls through to the
+// default unmatched response with a zero score.
+func TestRespondWithScore_BelowThreshold(t *testing.T) {
+	bot := NewBot("TestBot")
+	reply, matched, score := bot.RespondWithScore("something totally unrelated")
+	assert.Equal(t, "I'm not sure how to respond to that.", reply)
+	assert.Equal(t, "", matched)
+	assert.Equal(t, 0.0, score)
+}
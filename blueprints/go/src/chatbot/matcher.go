@@ -0,0 +1,127 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// matcher.go - Fuzzy trigger matching, used as a typo-tolerant fallback
+// when Rules has no exact match (see Rules.FuzzyMatch).
+
+package chatbot
+
+import (
+	"math"
+	"strings"
+)
+
+// Matcher scores how well an input string matches a candidate trigger, as
+// the maximum of two measures: Damerau-Levenshtein similarity (good for
+// single-word typos) and Jaccard similarity over word sets (good for
+// reordered or partially-overlapping multi-word triggers).
+type Matcher struct{}
+
+// NewMatcher returns a ready-to-use Matcher. It holds no state, so a zero
+// value Matcher{} also works.
+func NewMatcher() *Matcher {
+	return &Matcher{}
+}
+
+// Score returns max(edit_ratio, jaccard) for a and b, in [0, 1], where 1
+// means identical.
+func (m *Matcher) Score(a, b string) float64 {
+	return math.Max(editRatio(a, b), jaccardRatio(a, b))
+}
+
+// editRatio is 1 - distance/max(len(a), len(b)), i.e. the normalized
+// Damerau-Levenshtein similarity. Two empty strings are identical (1.0).
+func editRatio(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+	if maxLen == 0 {
+		return 1
+	}
+	return 1 - float64(damerauLevenshtein(ra, rb))/float64(maxLen)
+}
+
+// damerauLevenshtein computes the restricted Damerau-Levenshtein edit
+// distance (insertions, deletions, substitutions, and adjacent
+// transpositions) between a and b in O(n*m) time. It extends the classic
+// two-row Levenshtein DP with one extra row so the transposition rule can
+// look back two rows, keeping space at O(min(n,m)).
+func damerauLevenshtein(a, b []rune) int {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	n, m := len(a), len(b)
+
+	prevPrev := make([]int, n+1)
+	prev := make([]int, n+1)
+	cur := make([]int, n+1)
+	for i := 0; i <= n; i++ {
+		prev[i] = i
+	}
+
+	for j := 1; j <= m; j++ {
+		cur[0] = j
+		for i := 1; i <= n; i++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			best := min3(prev[i]+1, cur[i-1]+1, prev[i-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				if t := prevPrev[i-2] + 1; t < best {
+					best = t
+				}
+			}
+			cur[i] = best
+		}
+		prevPrev, prev, cur = prev, cur, prevPrev
+	}
+	return prev[n]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
+
+// jaccardRatio is the Jaccard similarity of a and b's whitespace-delimited
+// word sets: |intersection| / |union|. Two strings with no words are
+// identical (1.0).
+func jaccardRatio(a, b string) float64 {
+	setA := wordSet(a)
+	setB := wordSet(b)
+	if len(setA) == 0 && len(setB) == 0 {
+		return 1
+	}
+
+	intersection := 0
+	for word := range setA {
+		if setB[word] {
+			intersection++
+		}
+	}
+	union := len(setA) + len(setB) - intersection
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
+
+func wordSet(s string) map[string]bool {
+	words := strings.Fields(s)
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
@@ -0,0 +1,41 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// matcher_test.go - Unit tests for the Matcher's edit-distance and
+// word-overlap scoring.
+
+package chatbot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests that a single-character typo scores high via edit distance.
+func TestMatcher_Score_TypoTolerance(t *testing.T) {
+	m := NewMatcher()
+	assert.Greater(t, m.Score("helo", "hello"), 0.75)
+}
+
+// Tests that reordered, overlapping words score high via Jaccard, even
+// though their edit distance is large.
+func TestMatcher_Score_WordOverlap(t *testing.T) {
+	m := NewMatcher()
+	assert.Greater(t, m.Score("your name is what", "what is your name"), 0.75)
+}
+
+// Tests that unrelated strings score low.
+func TestMatcher_Score_Unrelated(t *testing.T) {
+	m := NewMatcher()
+	assert.Less(t, m.Score("hello", "goodbye forever"), 0.5)
+}
+
+// Tests that identical strings always score exactly 1.
+func TestMatcher_Score_Identical(t *testing.T) {
+	m := NewMatcher()
+	assert.Equal(t, 1.0, m.Score("hello", "hello"))
+	assert.Equal(t, 1.0, m.Score("", ""))
+}
@@ -0,0 +1,22 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// memory.go - The Memory interface Bot uses to remember per-user data
+// across turns (see memory/inmem, memory/file, memory/redis).
+
+package chatbot
+
+// Memory stores simple string key/value data for a Bot. Implementations
+// need not be safe across processes unless documented otherwise.
+type Memory interface {
+	// Get returns the value for key and whether it was found.
+	Get(key string) (value string, ok bool, err error)
+	// Set stores value under key, overwriting any existing value.
+	Set(key, value string) error
+	// Delete removes key. It is not an error if key does not exist.
+	Delete(key string) error
+	// Keys returns every stored key with the given prefix.
+	Keys(prefix string) ([]string, error)
+}
@@ -0,0 +1,130 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// file.go - A chatbot.Memory backed by a single JSON file, written with an
+// atomic rename so a crash mid-write can't corrupt it.
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Store is a chatbot.Memory persisted as a JSON object on disk.
+type Store struct {
+	path string
+	mu   sync.Mutex
+}
+
+// New returns a Store that reads from and writes to path, creating it on
+// first write if it does not yet exist.
+func New(path string) *Store {
+	return &Store{path: path}
+}
+
+// Get returns the value for key and whether it was found.
+func (s *Store) Get(key string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return "", false, err
+	}
+	value, ok := data[key]
+	return value, ok, nil
+}
+
+// Set stores value under key, overwriting any existing value.
+func (s *Store) Set(key, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return err
+	}
+	data[key] = value
+	return s.save(data)
+}
+
+// Delete removes key. It is not an error if key does not exist.
+func (s *Store) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return err
+	}
+	delete(data, key)
+	return s.save(data)
+}
+
+// Keys returns every stored key with the given prefix.
+func (s *Store) Keys(prefix string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for key := range data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// load reads and parses the store file, treating a missing file as empty.
+func (s *Store) load() (map[string]string, error) {
+	raw, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return make(map[string]string), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("chatbot: file memory: read %s: %w", s.path, err)
+	}
+	if len(raw) == 0 {
+		return make(map[string]string), nil
+	}
+	data := make(map[string]string)
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("chatbot: file memory: parse %s: %w", s.path, err)
+	}
+	return data, nil
+}
+
+// save writes data to s.path by writing to a temp file in the same
+// directory and renaming it over the target, so a reader never observes a
+// partially written file.
+func (s *Store) save(data map[string]string) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("chatbot: file memory: marshal: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	tmp, err := os.CreateTemp(dir, ".chatbot-memory-*.tmp")
+	if err != nil {
+		return fmt.Errorf("chatbot: file memory: create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("chatbot: file memory: write temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("chatbot: file memory: close temp file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("chatbot: file memory: rename into place: %w", err)
+	}
+	return nil
+}
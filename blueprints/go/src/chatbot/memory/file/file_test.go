@@ -0,0 +1,45 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// file_test.go - Runs the shared Memory conformance suite against Store,
+// plus a test that data survives reopening the same file.
+
+package file
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/memorytest"
+)
+
+func TestStore_Conformance(t *testing.T) {
+	dir := t.TempDir()
+	n := 0
+	memorytest.Conformance(t, func() chatbot.Memory {
+		n++
+		return New(filepath.Join(dir, fmt.Sprintf("memory-%d.json", n)))
+	})
+}
+
+// Tests that data set through one Store is visible to a new Store pointed
+// at the same file, i.e. it actually persists to disk.
+func TestStore_PersistsAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "memory.json")
+
+	first := New(path)
+	require.NoError(t, first.Set("name", "Ada"))
+
+	second := New(path)
+	value, ok, err := second.Get("name")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Ada", value)
+}
@@ -0,0 +1,61 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// inmem.go - An in-process chatbot.Memory backed by a map. Data does not
+// survive a restart; this is the default store used by chatbot.NewBot.
+package inmem
+
+import (
+	"strings"
+	"sync"
+)
+
+// Store is an in-process, concurrency-safe chatbot.Memory.
+type Store struct {
+	mu   sync.RWMutex
+	data map[string]string
+}
+
+// New returns an empty Store.
+func New() *Store {
+	return &Store{data: make(map[string]string)}
+}
+
+// Get returns the value for key and whether it was found.
+func (s *Store) Get(key string) (string, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.data[key]
+	return value, ok, nil
+}
+
+// Set stores value under key, overwriting any existing value.
+func (s *Store) Set(key, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = value
+	return nil
+}
+
+// Delete removes key. It is not an error if key does not exist.
+func (s *Store) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.data, key)
+	return nil
+}
+
+// Keys returns every stored key with the given prefix.
+func (s *Store) Keys(prefix string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var keys []string
+	for key := range s.data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
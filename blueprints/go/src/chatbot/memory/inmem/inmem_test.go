@@ -0,0 +1,20 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// inmem_test.go - Runs the shared Memory conformance suite against Store.
+
+package inmem_test
+
+import (
+	"testing"
+
+	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/memory/inmem"
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/memorytest"
+)
+
+func TestStore_Conformance(t *testing.T) {
+	memorytest.Conformance(t, func() chatbot.Memory { return inmem.New() })
+}
@@ -0,0 +1,69 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// redis.go - A chatbot.Memory backed by Redis, so data survives restarts
+// and can be shared across multiple bot instances.
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Store is a chatbot.Memory backed by a Redis server.
+type Store struct {
+	client *goredis.Client
+}
+
+// New returns a Store connected to the Redis server at addr (e.g.
+// "localhost:6379").
+func New(addr string) *Store {
+	return &Store{client: goredis.NewClient(&goredis.Options{Addr: addr})}
+}
+
+// Get returns the value for key and whether it was found.
+func (s *Store) Get(key string) (string, bool, error) {
+	value, err := s.client.Get(context.Background(), key).Result()
+	if err == goredis.Nil {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("chatbot: redis memory: get %s: %w", key, err)
+	}
+	return value, true, nil
+}
+
+// Set stores value under key, overwriting any existing value.
+func (s *Store) Set(key, value string) error {
+	if err := s.client.Set(context.Background(), key, value, 0).Err(); err != nil {
+		return fmt.Errorf("chatbot: redis memory: set %s: %w", key, err)
+	}
+	return nil
+}
+
+// Delete removes key. It is not an error if key does not exist.
+func (s *Store) Delete(key string) error {
+	if err := s.client.Del(context.Background(), key).Err(); err != nil {
+		return fmt.Errorf("chatbot: redis memory: delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// Keys returns every stored key with the given prefix, using SCAN so it
+// does not block the server on a large keyspace.
+func (s *Store) Keys(prefix string) ([]string, error) {
+	ctx := context.Background()
+	var keys []string
+	iter := s.client.Scan(ctx, 0, prefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("chatbot: redis memory: scan %s*: %w", prefix, err)
+	}
+	return keys, nil
+}
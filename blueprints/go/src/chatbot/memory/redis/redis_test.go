@@ -0,0 +1,34 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// redis_test.go - Runs the shared Memory conformance suite against Store.
+// Requires a real Redis server; skipped unless CHATBOT_TEST_REDIS_ADDR is
+// set, since there is no in-process fake for the Redis wire protocol here.
+
+package redis
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/memorytest"
+)
+
+func TestStore_Conformance(t *testing.T) {
+	addr := os.Getenv("CHATBOT_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("set CHATBOT_TEST_REDIS_ADDR to run against a real Redis server")
+	}
+
+	memorytest.Conformance(t, func() chatbot.Memory {
+		s := New(addr)
+		if err := s.client.FlushDB(context.Background()).Err(); err != nil {
+			t.Fatalf("flush redis test db: %v", err)
+		}
+		return s
+	})
+}
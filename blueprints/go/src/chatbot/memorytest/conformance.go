@@ -0,0 +1,72 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// conformance.go - A shared test suite any chatbot.Memory implementation
+// must pass. Used by memory/inmem, memory/file, and memory/redis's tests.
+package memorytest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+)
+
+// Conformance runs behavioral tests against a chatbot.Memory
+// implementation. newStore must return a fresh, empty store on each call.
+func Conformance(t *testing.T, newStore func() chatbot.Memory) {
+	t.Run("GetMissingKeyReturnsNotFound", func(t *testing.T) {
+		m := newStore()
+		_, ok, err := m.Get("missing")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("SetThenGet", func(t *testing.T) {
+		m := newStore()
+		require.NoError(t, m.Set("name", "Ada"))
+		value, ok, err := m.Get("name")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "Ada", value)
+	})
+
+	t.Run("SetOverwritesExistingValue", func(t *testing.T) {
+		m := newStore()
+		require.NoError(t, m.Set("name", "Ada"))
+		require.NoError(t, m.Set("name", "Grace"))
+		value, ok, err := m.Get("name")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "Grace", value)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		m := newStore()
+		require.NoError(t, m.Set("name", "Ada"))
+		require.NoError(t, m.Delete("name"))
+		_, ok, err := m.Get("name")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("DeleteMissingKeyIsNotAnError", func(t *testing.T) {
+		m := newStore()
+		assert.NoError(t, m.Delete("missing"))
+	})
+
+	t.Run("KeysReturnsOnlyMatchingPrefix", func(t *testing.T) {
+		m := newStore()
+		require.NoError(t, m.Set("user:1:name", "Ada"))
+		require.NoError(t, m.Set("user:1:topic", "go"))
+		require.NoError(t, m.Set("user:2:name", "Grace"))
+
+		keys, err := m.Keys("user:1:")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"user:1:name", "user:1:topic"}, keys)
+	})
+}
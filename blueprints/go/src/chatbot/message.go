@@ -0,0 +1,21 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// message.go - The Message type adapters and the Brain pass back and forth.
+
+package chatbot
+
+// Message is a single chat message flowing through a Brain, either
+// received from a user via an Adapter or sent back as the bot's reply.
+type Message struct {
+	// UserID identifies the sender (or, for a reply, the recipient) within
+	// the adapter's namespace, e.g. a Slack user ID or a webhook caller.
+	UserID string
+	// Text is the message body.
+	Text string
+	// ID optionally correlates a reply with the request that triggered it,
+	// for adapters (like adapters/http) that need to pair the two.
+	ID string
+}
@@ -0,0 +1,19 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// provider.go - The Provider interface Bot falls back to when no rule
+// matches (see providers/openai).
+
+package chatbot
+
+import "context"
+
+// Provider generates a response when Rules has no match for the input.
+// stream, if non-nil, is invoked once per token as the response arrives;
+// implementations that can't stream may instead invoke it once with the
+// full response. Complete must honor ctx cancellation.
+type Provider interface {
+	Complete(ctx context.Context, prompt string, stream func(token string)) (string, error)
+}
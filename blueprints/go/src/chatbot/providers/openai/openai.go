@@ -0,0 +1,205 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// openai.go - A chatbot.Provider that talks to the OpenAI chat completions
+// API over plain net/http, streaming the response via server-sent events.
+// No SDK is used; this is a deliberately small client.
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBaseURL = "https://api.openai.com/v1"
+	defaultModel   = "gpt-4o-mini"
+	maxRetries     = 5
+)
+
+// Provider is a chatbot.Provider backed by the OpenAI chat completions API.
+type Provider struct {
+	apiKey       string
+	model        string
+	systemPrompt string
+	baseURL      string
+	httpClient   *http.Client
+	retryBackoff time.Duration
+}
+
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithModel overrides the default model (gpt-4o-mini).
+func WithModel(model string) Option {
+	return func(p *Provider) { p.model = model }
+}
+
+// WithSystemPrompt sets a system message sent ahead of every prompt.
+func WithSystemPrompt(prompt string) Option {
+	return func(p *Provider) { p.systemPrompt = prompt }
+}
+
+// WithBaseURL overrides the API base URL, e.g. to point at a test server.
+func WithBaseURL(url string) Option {
+	return func(p *Provider) { p.baseURL = url }
+}
+
+// WithHTTPClient overrides the *http.Client used for requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(p *Provider) { p.httpClient = client }
+}
+
+// WithRetryBackoff overrides the initial backoff between 429 retries
+// (doubled on each subsequent retry). Mainly useful to speed up tests.
+func WithRetryBackoff(d time.Duration) Option {
+	return func(p *Provider) { p.retryBackoff = d }
+}
+
+// New returns a Provider that authenticates with apiKey.
+func New(apiKey string, opts ...Option) *Provider {
+	p := &Provider{
+		apiKey:       apiKey,
+		model:        defaultModel,
+		baseURL:      defaultBaseURL,
+		httpClient:   http.DefaultClient,
+		retryBackoff: time.Second,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+type streamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+// Complete sends prompt to the chat completions API and streams the reply
+// token by token through stream (if non-nil), returning the full text. It
+// retries with exponential backoff on HTTP 429, and honors ctx
+// cancellation both while waiting to retry and mid-stream.
+func (p *Provider) Complete(ctx context.Context, prompt string, stream func(token string)) (string, error) {
+	backoff := p.retryBackoff
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		resp, err := p.doRequest(ctx, prompt)
+		if err != nil {
+			return "", err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			resp.Body.Close()
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			backoff *= 2
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return "", fmt.Errorf("chatbot: openai: unexpected status %d: %s", resp.StatusCode, body)
+		}
+
+		reply, err := readStream(ctx, resp.Body, stream)
+		resp.Body.Close()
+		return reply, err
+	}
+	return "", fmt.Errorf("chatbot: openai: gave up after %d retries (429 Too Many Requests)", maxRetries)
+}
+
+// doRequest builds and sends the chat completions request.
+func (p *Provider) doRequest(ctx context.Context, prompt string) (*http.Response, error) {
+	var messages []chatMessage
+	if p.systemPrompt != "" {
+		messages = append(messages, chatMessage{Role: "system", Content: p.systemPrompt})
+	}
+	messages = append(messages, chatMessage{Role: "user", Content: prompt})
+
+	body, err := json.Marshal(chatRequest{Model: p.model, Messages: messages, Stream: true})
+	if err != nil {
+		return nil, fmt.Errorf("chatbot: openai: marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("chatbot: openai: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("chatbot: openai: request: %w", err)
+	}
+	return resp, nil
+}
+
+// readStream parses a server-sent events body of "data: {...}" lines
+// terminated by "data: [DONE]", invoking stream with each token of
+// content as it is decoded.
+func readStream(ctx context.Context, body io.Reader, stream func(token string)) (string, error) {
+	var full strings.Builder
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return full.String(), err
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		data, ok := strings.CutPrefix(line, "data: ")
+		if !ok || data == "" {
+			continue
+		}
+		if data == "[DONE]" {
+			return full.String(), nil
+		}
+
+		var chunk streamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return full.String(), fmt.Errorf("chatbot: openai: parse stream chunk: %w", err)
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+		if token := chunk.Choices[0].Delta.Content; token != "" {
+			full.WriteString(token)
+			if stream != nil {
+				stream(token)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return full.String(), fmt.Errorf("chatbot: openai: read stream: %w", err)
+	}
+	return full.String(), nil
+}
@@ -0,0 +1,118 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// openai_test.go - Tests SSE parsing, 429 retry/backoff, and mid-stream
+// cancellation against a mocked HTTP server.
+
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sseWrite(w http.ResponseWriter, flusher http.Flusher, data string) {
+	fmt.Fprintf(w, "data: %s\n\n", data)
+	flusher.Flush()
+}
+
+// Tests that a streamed chat completion is parsed token by token and that
+// the full response is reassembled correctly.
+func TestProvider_Complete_ParsesSSEStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		w.Header().Set("Content-Type", "text/event-stream")
+		sseWrite(w, flusher, `{"choices":[{"delta":{"content":"Hello"}}]}`)
+		sseWrite(w, flusher, `{"choices":[{"delta":{"content":", world!"}}]}`)
+		sseWrite(w, flusher, "[DONE]")
+	}))
+	defer server.Close()
+
+	p := New("test-key", WithBaseURL(server.URL))
+
+	var tokens []string
+	reply, err := p.Complete(context.Background(), "hi", func(token string) {
+		tokens = append(tokens, token)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, world!", reply)
+	assert.Equal(t, []string{"Hello", ", world!"}, tokens)
+}
+
+// Tests that a 429 response is retried with backoff rather than failing
+// immediately.
+func TestProvider_Complete_RetriesOn429(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		flusher := w.(http.Flusher)
+		w.Header().Set("Content-Type", "text/event-stream")
+		sseWrite(w, flusher, `{"choices":[{"delta":{"content":"ok"}}]}`)
+		sseWrite(w, flusher, "[DONE]")
+	}))
+	defer server.Close()
+
+	p := New("test-key", WithBaseURL(server.URL), WithRetryBackoff(10*time.Millisecond))
+
+	reply, err := p.Complete(context.Background(), "hi", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", reply)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+// Tests that canceling the context mid-stream stops Complete and surfaces
+// the cancellation, without hanging.
+func TestProvider_Complete_CancelsMidStream(t *testing.T) {
+	blockUntilCanceled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		w.Header().Set("Content-Type", "text/event-stream")
+		sseWrite(w, flusher, `{"choices":[{"delta":{"content":"partial"}}]}`)
+		<-blockUntilCanceled
+	}))
+	defer server.Close()
+	defer close(blockUntilCanceled)
+
+	p := New("test-key", WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var mu sync.Mutex
+	var tokens []string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := p.Complete(ctx, "hi", func(token string) {
+			mu.Lock()
+			tokens = append(tokens, token)
+			mu.Unlock()
+		})
+		assert.Error(t, err)
+	}()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(tokens) > 0
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Complete did not return after context cancellation")
+	}
+}
@@ -0,0 +1,287 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// rules.go - A small RiveScript-inspired rule engine that backs Bot.Respond.
+//
+// A script is plain text made of stanzas: one or more lines starting with
+// "+" declare trigger patterns for a rule (several "+" lines in a row are
+// synonyms for the same rule); an optional "%" line restricts the rule to
+// fire only when the bot's previous reply matched the given pattern,
+// enabling simple multi-turn flows; one or more "-" lines give candidate
+// responses, optionally tagged "{weight=N}" to bias weighted random
+// selection among siblings. Triggers may use "*" as a wildcard that
+// greedily captures one or more whitespace-delimited tokens; captured text
+// is available in responses as <star1>, <star2>, ... in trigger order.
+// See default.rive for a working example.
+package chatbot
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// response is a single candidate reply with its selection weight.
+type response struct {
+	text   string
+	weight int
+}
+
+// trigger is one compiled `+` pattern belonging to a rule.
+type trigger struct {
+	pattern    string
+	re         *regexp.Regexp
+	wildcards  int
+	literalLen int
+}
+
+// rule is one parsed stanza: one or more trigger synonyms, an optional
+// previous-reply context, and one or more weighted responses.
+type rule struct {
+	triggers   []trigger
+	previous   string
+	previousRe *regexp.Regexp
+	responses  []response
+}
+
+// Rules is a loaded set of trigger/response rules, RiveScript-style. The
+// zero value is an empty set ready for LoadFile/LoadString.
+type Rules struct {
+	rules []*rule
+}
+
+// NewRules returns an empty Rules set ready for LoadFile/LoadString.
+func NewRules() *Rules {
+	return &Rules{}
+}
+
+var weightTagRE = regexp.MustCompile(`\{weight=(\d+)\}\s*$`)
+
+// LoadFile reads the script at path and appends its rules to r.
+func (r *Rules) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("chatbot: load rules %s: %w", path, err)
+	}
+	return r.LoadString(string(data))
+}
+
+// LoadString parses src and appends its rules to r. Blank lines and lines
+// starting with // or # are ignored, so scripts can carry comments.
+func (r *Rules) LoadString(src string) error {
+	var cur *rule
+	scanner := bufio.NewScanner(strings.NewReader(src))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", strings.HasPrefix(line, "//"), strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "+"):
+			t := compileTrigger(normalize(strings.TrimSpace(line[1:])))
+			if cur == nil || len(cur.responses) > 0 {
+				cur = &rule{}
+				r.rules = append(r.rules, cur)
+			}
+			cur.triggers = append(cur.triggers, t)
+		case strings.HasPrefix(line, "%"):
+			if cur == nil {
+				return fmt.Errorf("chatbot: rules line %d: %% with no preceding + trigger", lineNo)
+			}
+			cur.previous = normalize(strings.TrimSpace(line[1:]))
+			cur.previousRe = compileTrigger(cur.previous).re
+		case strings.HasPrefix(line, "-"):
+			if cur == nil {
+				return fmt.Errorf("chatbot: rules line %d: - with no preceding + trigger", lineNo)
+			}
+			text, weight := parseResponse(strings.TrimSpace(line[1:]))
+			cur.responses = append(cur.responses, response{text: text, weight: weight})
+		default:
+			return fmt.Errorf("chatbot: rules line %d: expected +, -, or %%, got %q", lineNo, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("chatbot: scan rules: %w", err)
+	}
+	return nil
+}
+
+// parseResponse splits an optional trailing {weight=N} tag off a response
+// line, defaulting to weight 1.
+func parseResponse(text string) (string, int) {
+	if loc := weightTagRE.FindStringSubmatchIndex(text); loc != nil {
+		weight, err := strconv.Atoi(text[loc[2]:loc[3]])
+		if err != nil || weight < 1 {
+			weight = 1
+		}
+		return strings.TrimSpace(text[:loc[0]]), weight
+	}
+	return text, 1
+}
+
+// compileTrigger turns a normalized pattern into an anchored regexp, with
+// each "*" becoming a capturing group that matches one or more
+// whitespace-delimited tokens.
+func compileTrigger(pattern string) trigger {
+	parts := strings.Split(pattern, "*")
+	var b strings.Builder
+	b.WriteString("^")
+	for i, p := range parts {
+		b.WriteString(regexp.QuoteMeta(p))
+		if i != len(parts)-1 {
+			b.WriteString(`(\S+(?:\s+\S+)*)`)
+		}
+	}
+	b.WriteString("$")
+	return trigger{
+		pattern:    pattern,
+		re:         regexp.MustCompile(b.String()),
+		wildcards:  len(parts) - 1,
+		literalLen: len(pattern) - (len(parts) - 1),
+	}
+}
+
+// Match finds the most specific rule whose trigger matches input and whose
+// optional previous-reply context, if any, matches lastReply. vars supplies
+// additional named placeholders (e.g. "name") for interpolation alongside
+// the positional <starN> wildcard captures. matched is the trigger pattern
+// that fired. ok is false if no rule matched.
+func (r *Rules) Match(input, lastReply string, vars map[string]string) (reply, matched string, ok bool) {
+	in := normalize(input)
+	prev := normalize(lastReply)
+
+	var best *rule
+	var bestTrig trigger
+	var bestHasContext bool
+	var bestStars []string
+	found := false
+	for _, ru := range r.rules {
+		hasContext := ru.previousRe != nil
+		if hasContext && !ru.previousRe.MatchString(prev) {
+			continue
+		}
+		for _, t := range ru.triggers {
+			m := t.re.FindStringSubmatch(in)
+			if m == nil {
+				continue
+			}
+			if !found || moreSpecific(t, hasContext, bestTrig, bestHasContext) {
+				best = ru
+				bestTrig = t
+				bestHasContext = hasContext
+				bestStars = m[1:]
+				found = true
+			}
+		}
+	}
+	if !found || len(best.responses) == 0 {
+		return "", "", false
+	}
+	return interpolate(pickResponse(best.responses), bestStars, vars), bestTrig.pattern, true
+}
+
+// FuzzyMatch behaves like Match, except it ignores wildcard triggers and
+// instead scores every literal trigger against input using m, returning the
+// best-scoring rule whose score is at least minConfidence. Ties prefer the
+// shorter (more specific) trigger. It exists as a typo-tolerant fallback for
+// when Match finds no exact rule.
+func (r *Rules) FuzzyMatch(m *Matcher, input, lastReply string, vars map[string]string, minConfidence float64) (reply, matched string, score float64, ok bool) {
+	in := normalize(input)
+	prev := normalize(lastReply)
+
+	var best *rule
+	var bestTrig trigger
+	var bestScore float64
+	found := false
+	for _, ru := range r.rules {
+		if ru.previousRe != nil && !ru.previousRe.MatchString(prev) {
+			continue
+		}
+		for _, t := range ru.triggers {
+			if t.wildcards > 0 {
+				continue
+			}
+			s := m.Score(in, t.pattern)
+			if !found || s > bestScore || (s == bestScore && t.literalLen < bestTrig.literalLen) {
+				best = ru
+				bestTrig = t
+				bestScore = s
+				found = true
+			}
+		}
+	}
+	if !found || bestScore < minConfidence || len(best.responses) == 0 {
+		return "", "", bestScore, false
+	}
+	return interpolate(pickResponse(best.responses), nil, vars), bestTrig.pattern, bestScore, true
+}
+
+// moreSpecific reports whether candidate a is a better match than the
+// current best b: a satisfied "%" context line wins first (it is a strictly
+// narrower match than the same trigger without one), then fewer wildcards,
+// then the longer literal (non-wildcard) content.
+func moreSpecific(a trigger, aHasContext bool, b trigger, bHasContext bool) bool {
+	if aHasContext != bHasContext {
+		return aHasContext
+	}
+	if a.wildcards != b.wildcards {
+		return a.wildcards < b.wildcards
+	}
+	return a.literalLen > b.literalLen
+}
+
+// pickResponse chooses one response, weighted at random when there is more
+// than one candidate.
+func pickResponse(responses []response) string {
+	if len(responses) == 1 {
+		return responses[0].text
+	}
+	total := 0
+	for _, resp := range responses {
+		total += resp.weight
+	}
+	n := rand.Intn(total)
+	for _, resp := range responses {
+		if n < resp.weight {
+			return resp.text
+		}
+		n -= resp.weight
+	}
+	return responses[len(responses)-1].text
+}
+
+var tagRE = regexp.MustCompile(`<([a-zA-Z0-9]+)>`)
+
+// interpolate expands <starN> tags from stars (1-indexed, in trigger order)
+// and any other <tag> found in vars. Unknown tags are left untouched.
+func interpolate(text string, stars []string, vars map[string]string) string {
+	return tagRE.ReplaceAllStringFunc(text, func(tag string) string {
+		name := tag[1 : len(tag)-1]
+		if strings.HasPrefix(name, "star") {
+			if n, err := strconv.Atoi(name[len("star"):]); err == nil && n >= 1 && n <= len(stars) {
+				return stars[n-1]
+			}
+			return tag
+		}
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		return tag
+	})
+}
+
+// normalize lowercases, trims, collapses internal whitespace, and strips
+// all trailing punctuation characters, so e.g. "  Hi!! " and "hi" match
+// the same trigger.
+func normalize(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimRight(s, ".,!?;:")
+	return strings.Join(strings.Fields(s), " ")
+}
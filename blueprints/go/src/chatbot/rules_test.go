@@ -0,0 +1,179 @@
+// Blueprint for new Go projects
+// Author: Christi Mahu – https://christimahu.dev
+// Part of the dev repo: https://github.com/christimahu/dev/
+// This file is part of a minimal idiomatic Go blueprint for creating new applications.
+//
+// rules_test.go - Unit tests for the RiveScript-style rule engine, covering
+// wildcard capture, specificity, weighted responses, and "%" context lines.
+
+package chatbot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests that a wildcard trigger captures its match and interpolates it
+// back into the response via <star1>.
+func TestRules_WildcardCapture(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ my name is *
+- Nice to meet you, <star1>.
+`))
+
+	reply, _, ok := r.Match("my name is Ada", "", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "Nice to meet you, ada.", reply)
+}
+
+// Tests that a more specific (fewer wildcards) rule wins over a looser one
+// that also matches the same input.
+func TestRules_PrefersMoreSpecificRule(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ hello *
+- Generic greeting.
+
++ hello world
+- Specific greeting.
+`))
+
+	reply, _, ok := r.Match("hello world", "", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "Specific greeting.", reply)
+}
+
+// Tests that a "%" context line only fires when the bot's previous reply
+// matched the given pattern, enabling a simple multi-turn flow.
+func TestRules_PreviousContext(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ do you want to play a game
+- Sure, want to play a game?
+
++ yes
+% sure, want to play *
+- Great, let's play!
+
++ yes
+- Yes to what?
+`))
+
+	reply, _, ok := r.Match("yes", "Sure, want to play a game?", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "Great, let's play!", reply)
+
+	reply, _, ok = r.Match("yes", "", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "Yes to what?", reply)
+}
+
+// Tests that a {weight=N} tag biases random selection among sibling
+// responses, without ever selecting a lightly weighted (weight=1)
+// alternative more than a heavily weighted one across many draws.
+func TestRules_WeightedResponses(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ hi
+- rare {weight=1}
+- common {weight=99}
+`))
+
+	counts := map[string]int{}
+	for i := 0; i < 200; i++ {
+		reply, _, ok := r.Match("hi", "", nil)
+		require.True(t, ok)
+		counts[reply]++
+	}
+	assert.Greater(t, counts["common"], counts["rare"])
+}
+
+// Tests that unmatched input reports ok=false rather than an empty match.
+func TestRules_NoMatch(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ hi
+- Hello!
+`))
+
+	_, _, ok := r.Match("goodbye", "", nil)
+	assert.False(t, ok)
+}
+
+// Tests that LoadString rejects malformed scripts instead of silently
+// dropping lines.
+func TestRules_LoadString_Malformed(t *testing.T) {
+	r := NewRules()
+	err := r.LoadString("- orphan response")
+	assert.Error(t, err)
+}
+
+// Tests that a minor typo still matches its intended trigger once its score
+// clears minConfidence.
+func TestRules_FuzzyMatch_TypoTolerance(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ hello
+- Hi there!
+`))
+
+	reply, matched, score, ok := r.FuzzyMatch(NewMatcher(), "helo", "", nil, 0.75)
+	require.True(t, ok)
+	assert.Equal(t, "Hi there!", reply)
+	assert.Equal(t, "hello", matched)
+	assert.Greater(t, score, 0.75)
+}
+
+// Tests that among two triggers scoring equally, the shorter one wins. "ac"
+// is edit distance 1 from "ab" and edit distance 2 from "abcd", so both
+// score 0.5 - a genuine tie that only literal length can break.
+func TestRules_FuzzyMatch_PrefersShorterOnTie(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ ab
+- Short match.
+
++ abcd
+- Long match.
+`))
+
+	_, matched, score, ok := r.FuzzyMatch(NewMatcher(), "ac", "", nil, 0)
+	require.True(t, ok)
+	require.Equal(t, 0.5, score)
+	assert.Equal(t, "ab", matched)
+}
+
+// Tests that a score below minConfidence is rejected even though it is the
+// best available candidate.
+func TestRules_FuzzyMatch_ThresholdBoundary(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ hello
+- Hi there!
+`))
+
+	m := NewMatcher()
+	score := m.Score(normalize("xyz totally different"), "hello")
+
+	_, _, _, ok := r.FuzzyMatch(m, "xyz totally different", "", nil, score+0.01)
+	assert.False(t, ok, "score just above the candidate's own score must be rejected")
+
+	_, _, _, ok = r.FuzzyMatch(m, "xyz totally different", "", nil, score)
+	assert.True(t, ok, "score exactly at the candidate's own score must be accepted")
+}
+
+// Tests that wildcard triggers are never considered for fuzzy matching,
+// since a partial/typo match against an open-ended pattern isn't meaningful.
+func TestRules_FuzzyMatch_IgnoresWildcardTriggers(t *testing.T) {
+	r := NewRules()
+	require.NoError(t, r.LoadString(`
++ my name is *
+- Nice to meet you, <star1>.
+`))
+
+	_, _, _, ok := r.FuzzyMatch(NewMatcher(), "my name is", "", nil, 0)
+	assert.False(t, ok)
+}
@@ -3,36 +3,105 @@
 // Part of the dev repo: https://github.com/christimahu/dev/
 // This file is part of a minimal idiomatic Go blueprint for creating new applications.
 //
-// main.go - Entry point for the chatbot application. Accepts user input and
-// responds with canned responses via the chatbot package.
+// main.go - Entry point for the chatbot application. Selects an input
+// adapter via --adapter and wires it through a chatbot.Brain. With
+// --adapter cli --stream, it instead drives the terminal directly so
+// replies from an LLM Provider print token by token as they arrive.
 
 package main
 
 import (
 	"bufio"
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/christimahu/dev/blueprints/go/src/chatbot"
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/adapters/cli"
+	httpadapter "github.com/christimahu/dev/blueprints/go/src/chatbot/adapters/http"
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/adapters/slack"
+	"github.com/christimahu/dev/blueprints/go/src/chatbot/providers/openai"
 )
 
 func main() {
+	adapterName := flag.String("adapter", "cli", "input adapter to use: cli, slack, or http")
+	httpAddr := flag.String("http-addr", ":8080", "listen address for the http adapter")
+	stream := flag.Bool("stream", false, "print replies token by token as they stream from Provider (cli adapter only)")
+	flag.Parse()
+
 	bot := chatbot.NewBot("GoBot")
+	if apiKey := os.Getenv("OPENAI_API_KEY"); apiKey != "" {
+		bot.Provider = openai.New(apiKey)
+	}
 
-	fmt.Println("Chat with GoBot! Type 'bye' to exit.")
-	scanner := bufio.NewScanner(os.Stdin)
+	if *adapterName == "cli" && *stream {
+		runCLIStream(bot)
+		return
+	}
+
+	brain := chatbot.NewBrain(bot)
+
+	adapter, err := newAdapter(*adapterName, bot.Name, *httpAddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	brain.AddAdapter(adapter)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := brain.Run(ctx); err != nil && err != context.Canceled {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
 
+// newAdapter constructs the chatbot.Adapter named by name, reading any
+// credentials it needs from the environment.
+func newAdapter(name, botName, httpAddr string) (chatbot.Adapter, error) {
+	switch name {
+	case "cli":
+		return cli.New(botName), nil
+	case "http":
+		return httpadapter.New(httpAddr), nil
+	case "slack":
+		botToken := os.Getenv("SLACK_BOT_TOKEN")
+		appToken := os.Getenv("SLACK_APP_TOKEN")
+		if botToken == "" || appToken == "" {
+			return nil, fmt.Errorf("slack adapter requires SLACK_BOT_TOKEN and SLACK_APP_TOKEN")
+		}
+		return slack.New(botToken, appToken), nil
+	default:
+		return nil, fmt.Errorf("unknown adapter %q", name)
+	}
+}
+
+// runCLIStream runs the same interactive terminal loop as the cli adapter,
+// but prints each reply via Bot.RespondStream so tokens from a configured
+// Provider appear as they arrive instead of all at once.
+func runCLIStream(bot *chatbot.Bot) {
+	fmt.Printf("Chat with %s! Type 'bye' to exit.\n", bot.Name)
+	scanner := bufio.NewScanner(os.Stdin)
 	for {
 		fmt.Print("You: ")
 		if !scanner.Scan() {
-			break
+			return
 		}
 		input := strings.TrimSpace(scanner.Text())
-		if strings.ToLower(input) == "bye" {
-			fmt.Println("GoBot: Goodbye!")
-			break
+		if strings.EqualFold(input, "bye") {
+			fmt.Printf("%s: Goodbye!\n", bot.Name)
+			return
+		}
+
+		fmt.Printf("%s: ", bot.Name)
+		if _, err := bot.RespondStream(input, func(token string) { fmt.Print(token) }); err != nil {
+			fmt.Printf("[error: %v]", err)
 		}
-		fmt.Println("GoBot:", bot.Respond(input))
+		fmt.Println()
 	}
 }